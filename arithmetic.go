@@ -0,0 +1,103 @@
+package planner
+
+import "time"
+
+// approxDaysPerYear and approxDaysPerMonth are the average Gregorian
+// calendar lengths used by ApproxDuration, where no anchor is available to
+// resolve a Period's Years/Months component exactly.
+const (
+	approxDaysPerYear  = 365.2425
+	approxDaysPerMonth = 30.436875
+)
+
+// Add returns the component-wise sum of period and other, normalized.
+func (period Period) Add(other Period) Period {
+	return Period{
+		Years:   period.Years + other.Years,
+		Months:  period.Months + other.Months,
+		Weeks:   period.Weeks + other.Weeks,
+		Days:    period.Days + other.Days,
+		Hours:   period.Hours + other.Hours,
+		Minutes: period.Minutes + other.Minutes,
+		Seconds: period.Seconds + other.Seconds,
+	}.Normalize()
+}
+
+// Sub returns the component-wise difference of period and other, normalized.
+func (period Period) Sub(other Period) Period {
+	return period.Add(other.Negate())
+}
+
+// Negate returns period with every component's sign flipped.
+func (period Period) Negate() Period {
+	return negatePeriod(period)
+}
+
+// Scale returns period with every component multiplied by factor, normalized.
+func (period Period) Scale(factor int) Period {
+	return Period{
+		Years:   period.Years * factor,
+		Months:  period.Months * factor,
+		Weeks:   period.Weeks * factor,
+		Days:    period.Days * factor,
+		Hours:   period.Hours * factor,
+		Minutes: period.Minutes * factor,
+		Seconds: period.Seconds * factor,
+	}.Normalize()
+}
+
+// Abs returns period negated if its largest non-zero component is negative,
+// and period unchanged otherwise. Components of mixed sign (as Normalize can
+// leave them, e.g. 1 year and -2 months) are not each made positive.
+func (period Period) Abs() Period {
+	for _, u := range period.orderedUnits() {
+		if u.value < 0 {
+			return period.Negate()
+		}
+		if u.value > 0 {
+			return period
+		}
+	}
+
+	return period
+}
+
+// Equal reports whether period and other apply to ref to produce the same
+// timestamp. Two periods can only be compared this way, relative to an
+// anchor, since month and day lengths vary.
+func (period Period) Equal(other Period, ref time.Time) bool {
+	return period.Apply(ref).Equal(other.Apply(ref))
+}
+
+// Less reports whether period applied to ref produces an earlier timestamp than other applied to ref.
+func (period Period) Less(other Period, ref time.Time) bool {
+	return period.Apply(ref).Before(other.Apply(ref))
+}
+
+// ApproxDuration returns a rough time.Duration estimate of the period, using
+// 365.2425-day years and 30.436875-day months, for callers that need a sort
+// key rather than an exact value. loc is accepted for symmetry with the
+// package's other calendar-aware methods but does not affect the estimate.
+func (period Period) ApproxDuration(loc *time.Location) time.Duration {
+	days := float64(period.Years)*approxDaysPerYear +
+		float64(period.Months)*approxDaysPerMonth +
+		float64(period.Weeks)*7 +
+		float64(period.Days)
+
+	return time.Duration(days*24*float64(time.Hour)) +
+		time.Hour*time.Duration(period.Hours) +
+		time.Minute*time.Duration(period.Minutes) +
+		time.Second*time.Duration(period.Seconds)
+}
+
+// AsWeeks folds as many whole Days as possible into Weeks.
+func (period Period) AsWeeks() Period {
+	period.Weeks += period.Days / 7
+	period.Days %= 7
+	return period
+}
+
+// Simplify expands Weeks into Days via Normalize; AsWeeks performs the reverse.
+func (period Period) Simplify() Period {
+	return period.Normalize()
+}
@@ -0,0 +1,82 @@
+package planner
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPeriodAdd(t *testing.T) {
+	result := Period{Months: 10, Days: 20}.Add(Period{Months: 5, Days: 15})
+	if result != (Period{Years: 1, Months: 3, Days: 35}) {
+		t.Fatalf("Unexpected sum: %v", result)
+	}
+}
+
+func TestPeriodSub(t *testing.T) {
+	result := Period{Years: 1, Months: 3}.Sub(Period{Months: 5})
+	if result != (Period{Years: 1, Months: -2}) {
+		t.Fatalf("Unexpected difference: %v", result)
+	}
+}
+
+func TestPeriodNegate(t *testing.T) {
+	result := Period{Years: 1, Months: -2, Days: 3}.Negate()
+	if result != (Period{Years: -1, Months: 2, Days: -3}) {
+		t.Fatalf("Unexpected negation: %v", result)
+	}
+}
+
+func TestPeriodScale(t *testing.T) {
+	result := Period{Days: 3, Hours: 5}.Scale(3)
+	if result != (Period{Days: 9, Hours: 15}) {
+		t.Fatalf("Unexpected scale: %v", result)
+	}
+}
+
+func TestPeriodAbs(t *testing.T) {
+	result := Period{Years: -1, Months: -2, Days: -3}.Abs()
+	if result != (Period{Years: 1, Months: 2, Days: 3}) {
+		t.Fatalf("Unexpected abs: %v", result)
+	}
+
+	result = Period{Years: 1, Months: 2}.Abs()
+	if result != (Period{Years: 1, Months: 2}) {
+		t.Fatalf("Unexpected abs: %v", result)
+	}
+}
+
+func TestPeriodEqualAndLess(t *testing.T) {
+	ref := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	a := Period{Days: 28}
+	b := Period{Weeks: 4}
+
+	if !a.Equal(b, ref) {
+		t.Fatalf("Expected %v to equal %v relative to %v", a, b, ref)
+	}
+
+	c := Period{Days: 29}
+	if !b.Less(c, ref) {
+		t.Fatalf("Expected %v to be less than %v relative to %v", b, c, ref)
+	}
+}
+
+func TestPeriodApproxDuration(t *testing.T) {
+	result := Period{Years: 1}.ApproxDuration(time.UTC)
+	expected := time.Duration(approxDaysPerYear*24*float64(time.Hour))
+	if result != expected {
+		t.Fatalf("Unexpected approx duration: %v", result)
+	}
+}
+
+func TestPeriodAsWeeksAndSimplify(t *testing.T) {
+	result := Period{Days: 16}.AsWeeks()
+	if result != (Period{Weeks: 2, Days: 2}) {
+		t.Fatalf("Unexpected AsWeeks: %v", result)
+	}
+
+	result = Period{Weeks: 2, Days: 2}.Simplify()
+	if result != (Period{Days: 16}) {
+		t.Fatalf("Unexpected Simplify: %v", result)
+	}
+}
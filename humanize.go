@@ -0,0 +1,186 @@
+package planner
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Localizer translates a unit name ("year", "month", "week", "day", "hour",
+// "minute" or "second") and its count into locale-specific text, so callers
+// can plug in something like a golang.org/x/text/message catalog.
+type Localizer interface {
+	Translate(unit string, n int) string
+}
+
+var unitAbbreviations = map[string]string{
+	"year": "y", "month": "mo", "week": "w", "day": "d", "hour": "h", "minute": "min", "second": "s",
+}
+
+type humanizeOptions struct {
+	separator     string
+	lastSeparator string
+	maxUnits      int
+	abbreviate    bool
+	localizer     Localizer
+}
+
+// HumanizeOption configures Period.Humanize.
+type HumanizeOption func(*humanizeOptions)
+
+// WithSeparator sets the separator placed between all but the last two rendered units. Default ", ".
+func WithSeparator(sep string) HumanizeOption {
+	return func(o *humanizeOptions) { o.separator = sep }
+}
+
+// WithLastSeparator sets the separator placed before the final rendered unit. Default " and ".
+func WithLastSeparator(sep string) HumanizeOption {
+	return func(o *humanizeOptions) { o.lastSeparator = sep }
+}
+
+// WithMaxUnits limits the number of rendered units, largest first.
+func WithMaxUnits(n int) HumanizeOption {
+	return func(o *humanizeOptions) { o.maxUnits = n }
+}
+
+// WithAbbreviate renders abbreviated units ("1y 2mo 3d") instead of full words,
+// and switches the default separators to a single space unless overridden.
+func WithAbbreviate() HumanizeOption {
+	return func(o *humanizeOptions) { o.abbreviate = true }
+}
+
+// WithLocalizer renders unit names through localizer instead of the built-in English words.
+func WithLocalizer(localizer Localizer) HumanizeOption {
+	return func(o *humanizeOptions) { o.localizer = localizer }
+}
+
+type unitValue struct {
+	name  string
+	value int
+}
+
+func (period Period) orderedUnits() []unitValue {
+	return []unitValue{
+		{"year", period.Years},
+		{"month", period.Months},
+		{"week", period.Weeks},
+		{"day", period.Days},
+		{"hour", period.Hours},
+		{"minute", period.Minutes},
+		{"second", period.Seconds},
+	}
+}
+
+func (period Period) nonZeroUnits() []unitValue {
+	var result []unitValue
+	for _, u := range period.orderedUnits() {
+		if u.value != 0 {
+			result = append(result, u)
+		}
+	}
+	return result
+}
+
+// Humanize renders the period as a human-readable phrase such as
+// "1 year, 2 months and 3 days", with correct pluralization. By default all
+// non-zero units are rendered, separated by ", " and, before the last unit,
+// " and "; pass HumanizeOptions to limit the unit count (WithMaxUnits),
+// abbreviate units (WithAbbreviate), change separators (WithSeparator,
+// WithLastSeparator) or localize unit names (WithLocalizer).
+func (period Period) Humanize(opts ...HumanizeOption) string {
+	options := humanizeOptions{separator: ", ", lastSeparator: " and ", maxUnits: 7}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if options.abbreviate && options.separator == ", " && options.lastSeparator == " and " {
+		options.separator, options.lastSeparator = " ", " "
+	}
+
+	units := period.nonZeroUnits()
+	if len(units) == 0 {
+		units = []unitValue{{"second", 0}}
+	}
+	if len(units) > options.maxUnits {
+		units = units[:options.maxUnits]
+	}
+
+	phrases := make([]string, len(units))
+	for i, u := range units {
+		phrases[i] = formatUnit(options, u.name, u.value)
+	}
+
+	return joinPhrases(phrases, options.separator, options.lastSeparator)
+}
+
+func formatUnit(options humanizeOptions, unit string, n int) string {
+	switch {
+	case options.abbreviate:
+		return fmt.Sprintf("%d%s", n, unitAbbreviations[unit])
+	case options.localizer != nil:
+		return options.localizer.Translate(unit, n)
+	default:
+		return fmt.Sprintf("%d %s", n, pluralize(unit, n))
+	}
+}
+
+func joinPhrases(phrases []string, separator, lastSeparator string) string {
+	if len(phrases) == 1 {
+		return phrases[0]
+	}
+
+	return strings.Join(phrases[:len(phrases)-1], separator) + lastSeparator + phrases[len(phrases)-1]
+}
+
+func largestUnit(period Period) (int, string) {
+	switch {
+	case period.Years != 0:
+		return period.Years, "year"
+	case period.Months != 0:
+		return period.Months, "month"
+	case period.Weeks != 0:
+		return period.Weeks, "week"
+	case period.Days != 0:
+		return period.Days, "day"
+	case period.Hours != 0:
+		return period.Hours, "hour"
+	case period.Minutes != 0:
+		return period.Minutes, "minute"
+	default:
+		return period.Seconds, "second"
+	}
+}
+
+func pluralize(unit string, n int) string {
+	if n == 1 || n == -1 {
+		return unit
+	}
+	return unit + "s"
+}
+
+// HumanizeRelative renders the period as a short relative phrase anchored at
+// ref, e.g. "in 3 days" for a period that lands after ref, or "2 hours ago"
+// for one that lands before it. ref resolves the true length of any Years or
+// Months component so the rendered magnitude reflects the actual calendar span.
+func (period Period) HumanizeRelative(ref time.Time) string {
+	target := period.Apply(ref)
+
+	if target.Equal(ref) {
+		return "now"
+	}
+
+	var elapsed Period
+	if target.After(ref) {
+		elapsed = Between(ref, target, ref.Location())
+	} else {
+		elapsed = Between(target, ref, ref.Location())
+	}
+
+	value, unit := largestUnit(elapsed)
+	phrase := fmt.Sprintf("%d %s", value, pluralize(unit, value))
+
+	if target.Before(ref) {
+		return phrase + " ago"
+	}
+	return "in " + phrase
+}
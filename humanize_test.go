@@ -0,0 +1,82 @@
+package planner
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPeriodHumanizeDefault(t *testing.T) {
+	period := Period{Years: 1, Months: 2, Days: 3}
+	if result := period.Humanize(); result != "1 year, 2 months and 3 days" {
+		t.Fatalf("Unexpected humanize: %q", result)
+	}
+}
+
+func TestPeriodHumanizeMaxUnits(t *testing.T) {
+	period := Period{Years: 1, Months: 2, Days: 3}
+	if result := period.Humanize(WithMaxUnits(2)); result != "1 year and 2 months" {
+		t.Fatalf("Unexpected humanize: %q", result)
+	}
+}
+
+func TestPeriodHumanizeAbbreviate(t *testing.T) {
+	period := Period{Years: 1, Months: 2, Days: 3}
+	if result := period.Humanize(WithAbbreviate()); result != "1y 2mo 3d" {
+		t.Fatalf("Unexpected humanize: %q", result)
+	}
+}
+
+func TestPeriodHumanizeSeparators(t *testing.T) {
+	period := Period{Years: 1, Months: 2, Days: 3}
+	result := period.Humanize(WithSeparator("; "), WithLastSeparator(" & "))
+	if result != "1 year; 2 months & 3 days" {
+		t.Fatalf("Unexpected humanize: %q", result)
+	}
+}
+
+func TestPeriodHumanizeSingular(t *testing.T) {
+	period := Period{Years: 1}
+	if result := period.Humanize(); result != "1 year" {
+		t.Fatalf("Unexpected humanize: %q", result)
+	}
+}
+
+func TestPeriodHumanizeNegativeSingular(t *testing.T) {
+	period := Period{Years: -1}
+	if result := period.Humanize(); result != "-1 year" {
+		t.Fatalf("Unexpected humanize: %q", result)
+	}
+}
+
+type upperLocalizer struct{}
+
+func (upperLocalizer) Translate(unit string, n int) string {
+	if n == 1 {
+		return "1 " + unit + "!"
+	}
+	return "many " + unit + "s!"
+}
+
+func TestPeriodHumanizeLocalizer(t *testing.T) {
+	period := Period{Years: 1, Days: 3}
+	result := period.Humanize(WithLocalizer(upperLocalizer{}))
+	if result != "1 year! and many days!" {
+		t.Fatalf("Unexpected humanize: %q", result)
+	}
+}
+
+func TestPeriodHumanizeRelative(t *testing.T) {
+	ref := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	if result := (Period{Days: 3}).HumanizeRelative(ref); result != "in 3 days" {
+		t.Fatalf("Unexpected relative humanize: %q", result)
+	}
+
+	if result := (Period{Hours: -2}).HumanizeRelative(ref); result != "2 hours ago" {
+		t.Fatalf("Unexpected relative humanize: %q", result)
+	}
+
+	if result := (Period{}).HumanizeRelative(ref); result != "now" {
+		t.Fatalf("Unexpected relative humanize: %q", result)
+	}
+}
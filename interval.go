@@ -0,0 +1,249 @@
+package planner
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var errIntervalInvalid = fmt.Errorf("Invalid interval")
+var errIntervalBadFormat = fmt.Errorf("Bad interval format")
+
+// Interval is a calendar interval between two timestamps, in contrast to Period
+// which is calendar-independent until applied to a reference timestamp.
+// Interval is half-open: Start is included, End is excluded.
+type Interval struct {
+	Start time.Time
+	End   time.Time
+}
+
+// IntervalFromString returns an interval parsed from one of the ISO-8601
+// range forms "<start>/<end>", "<start>/<period>", "<period>/<end>" or the
+// repeating form "R[n]/<start>/<period>", where n is the repeat count and
+// the returned interval spans all n occurrences (so that Split or Iterate
+// with the same period recovers the individual occurrences). A repeating form
+// without a count ("R/<start>/<period>") returns errIntervalBadFormat.
+func IntervalFromString(str string) (*Interval, error) {
+	parts := strings.Split(str, "/")
+
+	switch len(parts) {
+	case 2:
+		return intervalFromBounds(parts[0], parts[1])
+	case 3:
+		return intervalFromRepeat(parts[0], parts[1], parts[2])
+	default:
+		return nil, errIntervalBadFormat
+	}
+}
+
+func intervalFromBounds(a, b string) (*Interval, error) {
+	aIsPeriod := strings.HasPrefix(a, "P")
+	bIsPeriod := strings.HasPrefix(b, "P")
+
+	switch {
+	case !aIsPeriod && !bIsPeriod:
+		start, err := time.Parse(time.RFC3339, a)
+		if err != nil {
+			return nil, err
+		}
+
+		end, err := time.Parse(time.RFC3339, b)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Interval{Start: start, End: end}, nil
+
+	case !aIsPeriod && bIsPeriod:
+		start, err := time.Parse(time.RFC3339, a)
+		if err != nil {
+			return nil, err
+		}
+
+		period, err := FromString(b)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Interval{Start: start, End: period.Apply(start)}, nil
+
+	case aIsPeriod && !bIsPeriod:
+		end, err := time.Parse(time.RFC3339, b)
+		if err != nil {
+			return nil, err
+		}
+
+		period, err := FromString(a)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Interval{Start: negatePeriod(*period).Apply(end), End: end}, nil
+
+	default:
+		return nil, errIntervalBadFormat
+	}
+}
+
+func intervalFromRepeat(r, a, b string) (*Interval, error) {
+	if !strings.HasPrefix(r, "R") {
+		return nil, errIntervalBadFormat
+	}
+
+	countStr := strings.TrimPrefix(r, "R")
+	if countStr == "" {
+		// an unbounded repeat count has no finite end to report as Interval.End
+		return nil, errIntervalBadFormat
+	}
+
+	count, err := strconv.Atoi(countStr)
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := time.Parse(time.RFC3339, a)
+	if err != nil {
+		return nil, err
+	}
+
+	period, err := FromString(b)
+	if err != nil {
+		return nil, err
+	}
+
+	end := start
+	for i := 0; i < count; i++ {
+		end = period.Apply(end)
+	}
+
+	return &Interval{Start: start, End: end}, nil
+}
+
+// Contains returns true iff t falls within the interval, Start inclusive and End exclusive.
+func (iv Interval) Contains(t time.Time) bool {
+	return !t.Before(iv.Start) && t.Before(iv.End)
+}
+
+// Overlaps returns true iff iv and other share any instant.
+func (iv Interval) Overlaps(other Interval) bool {
+	return iv.Start.Before(other.End) && other.Start.Before(iv.End)
+}
+
+// Intersect returns the overlap between iv and other, and false if they do not overlap.
+func (iv Interval) Intersect(other Interval) (Interval, bool) {
+	if !iv.Overlaps(other) {
+		return Interval{}, false
+	}
+
+	return Interval{Start: maxTime(iv.Start, other.Start), End: minTime(iv.End, other.End)}, true
+}
+
+// Union returns the smallest set of non-overlapping intervals covering iv and other:
+// a single merged interval when they overlap or are adjacent, otherwise both, Start-ordered.
+// It returns errIntervalInvalid if either interval has a Start after its End.
+func (iv Interval) Union(other Interval) ([]Interval, error) {
+	if iv.Start.After(iv.End) || other.Start.After(other.End) {
+		return nil, errIntervalInvalid
+	}
+
+	if iv.Overlaps(other) || iv.End.Equal(other.Start) || other.End.Equal(iv.Start) {
+		return []Interval{{Start: minTime(iv.Start, other.Start), End: maxTime(iv.End, other.End)}}, nil
+	}
+
+	if iv.Start.Before(other.Start) {
+		return []Interval{iv, other}, nil
+	}
+
+	return []Interval{other, iv}, nil
+}
+
+// Subtract returns the parts of iv that do not overlap with other, as zero, one or two intervals.
+func (iv Interval) Subtract(other Interval) []Interval {
+	overlap, ok := iv.Intersect(other)
+	if !ok {
+		return []Interval{iv}
+	}
+
+	var result []Interval
+	if iv.Start.Before(overlap.Start) {
+		result = append(result, Interval{Start: iv.Start, End: overlap.Start})
+	}
+	if overlap.End.Before(iv.End) {
+		result = append(result, Interval{Start: overlap.End, End: iv.End})
+	}
+
+	return result
+}
+
+// Duration returns the length of the interval.
+func (iv Interval) Duration() time.Duration {
+	return iv.End.Sub(iv.Start)
+}
+
+// Split breaks the interval into consecutive sub-intervals of length p, in Start order,
+// with the final sub-interval clipped to iv.End. It returns nil if p is the zero Period,
+// and stops early, without erroring, if p does not advance t forward.
+func (iv Interval) Split(p Period) []Interval {
+	if p == (Period{}) {
+		return nil
+	}
+
+	var result []Interval
+	for t := iv.Start; t.Before(iv.End); {
+		next := p.Apply(t)
+		if !next.After(t) {
+			break
+		}
+		if next.After(iv.End) {
+			next = iv.End
+		}
+
+		result = append(result, Interval{Start: t, End: next})
+		t = next
+	}
+
+	return result
+}
+
+// Iterate calls yield with the Start of each successive tick of p within the
+// interval, in order, stopping once a tick reaches or passes iv.End or yield
+// returns false. It also stops, without erroring, if p does not advance t
+// forward (after yielding the non-advancing tick once).
+func (iv Interval) Iterate(p Period, yield func(time.Time) bool) {
+	if p == (Period{}) {
+		return
+	}
+
+	for t := iv.Start; t.Before(iv.End); {
+		if !yield(t) {
+			return
+		}
+
+		next := p.Apply(t)
+		if !next.After(t) {
+			return
+		}
+		t = next
+	}
+}
+
+// Between returns the Period between iv.Start and iv.End, in iv's own location.
+// The receiver is unused; call it as (Period{}).Between(iv).
+func (period Period) Between(iv Interval) Period {
+	return Between(iv.Start, iv.End, iv.Start.Location())
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
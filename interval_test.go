@@ -0,0 +1,161 @@
+package planner
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIntervalFromString(t *testing.T) {
+	result, err := IntervalFromString("2024-01-01T00:00:00Z/2024-03-15T00:00:00Z")
+	if err != nil || result.Start != time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) || result.End != time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("Unexpected interval: %v %v", result, err)
+	}
+
+	result, err = IntervalFromString("2024-01-01T00:00:00Z/P2W")
+	if err != nil || result.Start != time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) || result.End != time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("Unexpected interval: %v %v", result, err)
+	}
+
+	result, err = IntervalFromString("P2W/2024-01-15T00:00:00Z")
+	if err != nil || result.Start != time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) || result.End != time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("Unexpected interval: %v %v", result, err)
+	}
+
+	result, err = IntervalFromString("R5/2024-01-01T00:00:00Z/P1W")
+	if err != nil || result.Start != time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) || result.End != time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("Unexpected interval: %v %v", result, err)
+	}
+
+	_, err = IntervalFromString("not-a-valid-range")
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+}
+
+func TestIntervalContains(t *testing.T) {
+	iv := Interval{Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)}
+
+	if !iv.Contains(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Fatal("Expected containment")
+	}
+	if !iv.Contains(iv.Start) {
+		t.Fatal("Expected Start to be contained")
+	}
+	if iv.Contains(iv.End) {
+		t.Fatal("Expected End to be excluded")
+	}
+}
+
+func TestIntervalOverlapsAndIntersect(t *testing.T) {
+	a := Interval{Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)}
+	b := Interval{Start: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)}
+
+	if !a.Overlaps(b) {
+		t.Fatal("Expected overlap")
+	}
+
+	result, ok := a.Intersect(b)
+	expected := Interval{Start: b.Start, End: a.End}
+	if !ok || result != expected {
+		t.Fatalf("Unexpected intersection: %v", result)
+	}
+
+	c := Interval{Start: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)}
+	if a.Overlaps(c) {
+		t.Fatal("Unexpected overlap")
+	}
+	if _, ok := a.Intersect(c); ok {
+		t.Fatal("Unexpected intersection")
+	}
+}
+
+func TestIntervalUnion(t *testing.T) {
+	a := Interval{Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)}
+	b := Interval{Start: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)}
+
+	result, err := a.Union(b)
+	if err != nil || len(result) != 1 || result[0] != (Interval{Start: a.Start, End: b.End}) {
+		t.Fatalf("Unexpected union: %v %v", result, err)
+	}
+
+	c := Interval{Start: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)}
+	result, err = a.Union(c)
+	if err != nil || len(result) != 2 || result[0] != a || result[1] != c {
+		t.Fatalf("Unexpected union: %v %v", result, err)
+	}
+}
+
+func TestIntervalSubtract(t *testing.T) {
+	a := Interval{Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)}
+	b := Interval{Start: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)}
+
+	result := a.Subtract(b)
+	if len(result) != 2 || result[0] != (Interval{Start: a.Start, End: b.Start}) || result[1] != (Interval{Start: b.End, End: a.End}) {
+		t.Fatalf("Unexpected subtraction: %v", result)
+	}
+}
+
+func TestIntervalDuration(t *testing.T) {
+	iv := Interval{Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)}
+	if iv.Duration() != 24*time.Hour {
+		t.Fatalf("Unexpected duration: %v", iv.Duration())
+	}
+}
+
+func TestIntervalSplit(t *testing.T) {
+	iv := Interval{Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 1, 22, 0, 0, 0, 0, time.UTC)}
+	result := iv.Split(Period{Weeks: 1})
+
+	if len(result) != 3 {
+		t.Fatalf("Unexpected split count: %d", len(result))
+	}
+	if result[0] != (Interval{Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)}) {
+		t.Fatalf("Unexpected first split: %v", result[0])
+	}
+}
+
+func TestIntervalIterate(t *testing.T) {
+	iv := Interval{Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 1, 22, 0, 0, 0, 0, time.UTC)}
+
+	var ticks []time.Time
+	iv.Iterate(Period{Weeks: 1}, func(t time.Time) bool {
+		ticks = append(ticks, t)
+		return true
+	})
+
+	if len(ticks) != 3 {
+		t.Fatalf("Unexpected tick count: %d", len(ticks))
+	}
+}
+
+func TestIntervalSplitNonAdvancing(t *testing.T) {
+	iv := Interval{Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 1, 22, 0, 0, 0, 0, time.UTC)}
+
+	result := iv.Split(Period{Days: -1})
+	if result != nil {
+		t.Fatalf("Expected no splits for a non-advancing period, got: %v", result)
+	}
+}
+
+func TestIntervalIterateNonAdvancing(t *testing.T) {
+	iv := Interval{Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 1, 22, 0, 0, 0, 0, time.UTC)}
+
+	var ticks []time.Time
+	iv.Iterate(Period{Days: -1}, func(t time.Time) bool {
+		ticks = append(ticks, t)
+		return true
+	})
+
+	if len(ticks) != 1 {
+		t.Fatalf("Expected exactly one tick for a non-advancing period, got: %v", ticks)
+	}
+}
+
+func TestPeriodBetweenInterval(t *testing.T) {
+	iv := Interval{Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 2, 4, 0, 0, 0, 0, time.UTC)}
+
+	result := Period{}.Between(iv)
+	if result != (Period{Months: 1, Days: 3}) {
+		t.Fatalf("Unexpected period: %v", result)
+	}
+}
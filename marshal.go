@@ -0,0 +1,139 @@
+package planner
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"text/template"
+	"time"
+)
+
+// MarshalJSON implements json.Marshaler, encoding the period as its ISO-8601 string.
+func (period Period) MarshalJSON() ([]byte, error) {
+	return json.Marshal(period.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (period *Period) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	parsed, err := FromString(str)
+	if err != nil {
+		return err
+	}
+
+	*period = *parsed
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the period as its ISO-8601 string.
+func (period Period) MarshalText() ([]byte, error) {
+	return []byte(period.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (period *Period) UnmarshalText(text []byte) error {
+	parsed, err := FromString(string(text))
+	if err != nil {
+		return err
+	}
+
+	*period = *parsed
+	return nil
+}
+
+// MarshalXML implements xml.Marshaler, encoding the period as an element
+// whose character data is its ISO-8601 string.
+func (period Period) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(period.String(), start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler.
+func (period *Period) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var str string
+	if err := d.DecodeElement(&str, &start); err != nil {
+		return err
+	}
+
+	parsed, err := FromString(str)
+	if err != nil {
+		return err
+	}
+
+	*period = *parsed
+	return nil
+}
+
+// Value implements driver.Valuer, encoding the period as its ISO-8601 string
+// for storage in an interval-typed database column.
+func (period Period) Value() (driver.Value, error) {
+	return period.String(), nil
+}
+
+// Scan implements sql.Scanner, decoding an ISO-8601 string (or NULL) read back from a database column.
+func (period *Period) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*period = Period{}
+		return nil
+	case string:
+		return period.scanString(v)
+	case []byte:
+		return period.scanString(string(v))
+	default:
+		return fmt.Errorf("Period.Scan: unsupported type %T", src)
+	}
+}
+
+func (period *Period) scanString(str string) error {
+	parsed, err := FromString(str)
+	if err != nil {
+		return err
+	}
+
+	*period = *parsed
+	return nil
+}
+
+// FuncMap returns template helper functions for working with periods and
+// intervals: period, add_period, between and humanize. The result is a
+// text/template.FuncMap; pass it through template.FuncMap(planner.FuncMap())
+// to use it with html/template instead.
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"period":     funcPeriod,
+		"add_period": funcAddPeriod,
+		"between":    funcBetween,
+		"humanize":   funcHumanize,
+	}
+}
+
+func funcPeriod(str string) (Period, error) {
+	parsed, err := FromString(str)
+	if err != nil {
+		return Period{}, err
+	}
+	return *parsed, nil
+}
+
+func funcAddPeriod(str string, t time.Time) (time.Time, error) {
+	parsed, err := FromString(str)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return parsed.Apply(t), nil
+}
+
+func funcBetween(start, end time.Time) Period {
+	return Between(start, end, start.Location())
+}
+
+// funcHumanize renders t relative to now, e.g. "in 3 days" or "2 hours ago".
+func funcHumanize(t time.Time) string {
+	now := time.Now()
+	return Between(now, t, t.Location()).HumanizeRelative(now)
+}
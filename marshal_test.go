@@ -0,0 +1,106 @@
+package planner
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+	texttemplate "text/template"
+)
+
+func TestPeriodJSONRoundTrip(t *testing.T) {
+	period := Period{Years: 1, Months: 2, Days: 3, Hours: 4}
+
+	data, err := json.Marshal(period)
+	if err != nil || string(data) != `"P1Y2M3DT4H"` {
+		t.Fatalf("Unexpected marshal: %s %v", data, err)
+	}
+
+	var result Period
+	if err := json.Unmarshal(data, &result); err != nil || result != period {
+		t.Fatalf("Unexpected unmarshal: %v %v", result, err)
+	}
+}
+
+func TestPeriodTextRoundTrip(t *testing.T) {
+	period := Period{Weeks: 7}
+
+	text, err := period.MarshalText()
+	if err != nil || string(text) != "P7W" {
+		t.Fatalf("Unexpected marshal: %s %v", text, err)
+	}
+
+	var result Period
+	if err := result.UnmarshalText(text); err != nil || result != period {
+		t.Fatalf("Unexpected unmarshal: %v %v", result, err)
+	}
+}
+
+type xmlWrapper struct {
+	XMLName xml.Name `xml:"wrapper"`
+	Period  Period   `xml:"period"`
+}
+
+func TestPeriodXMLRoundTrip(t *testing.T) {
+	wrapper := xmlWrapper{Period: Period{Years: 6, Months: 5, Days: 4, Hours: 3, Minutes: 2, Seconds: 1}}
+
+	data, err := xml.Marshal(wrapper)
+	if err != nil {
+		t.Fatalf("Unexpected marshal error: %v", err)
+	}
+
+	var result xmlWrapper
+	if err := xml.Unmarshal(data, &result); err != nil || result.Period != wrapper.Period {
+		t.Fatalf("Unexpected unmarshal: %v %v", result, err)
+	}
+}
+
+func TestPeriodSQLValueAndScan(t *testing.T) {
+	period := Period{Days: 4}
+
+	value, err := period.Value()
+	if err != nil || value != "P4D" {
+		t.Fatalf("Unexpected value: %v %v", value, err)
+	}
+
+	var result Period
+	if err := result.Scan("P4D"); err != nil || result != period {
+		t.Fatalf("Unexpected scan: %v %v", result, err)
+	}
+
+	if err := result.Scan([]byte("P4D")); err != nil || result != period {
+		t.Fatalf("Unexpected scan: %v %v", result, err)
+	}
+
+	if err := result.Scan(nil); err != nil || result != (Period{}) {
+		t.Fatalf("Unexpected scan: %v %v", result, err)
+	}
+
+	if err := result.Scan(42); err == nil {
+		t.Fatal("Expected error for unsupported type")
+	}
+}
+
+func TestFuncMap(t *testing.T) {
+	funcMap := FuncMap()
+
+	tmpl := texttemplate.Must(texttemplate.New("test").Funcs(funcMap).Parse(`{{ period "P3D" }}`))
+
+	var buf []byte
+	writer := &sliceWriter{&buf}
+	if err := tmpl.Execute(writer, nil); err != nil {
+		t.Fatalf("Unexpected execute error: %v", err)
+	}
+
+	if string(buf) != "P3D" {
+		t.Fatalf("Unexpected output: %s", buf)
+	}
+}
+
+type sliceWriter struct {
+	buf *[]byte
+}
+
+func (w *sliceWriter) Write(p []byte) (int, error) {
+	*w.buf = append(*w.buf, p...)
+	return len(p), nil
+}
@@ -0,0 +1,377 @@
+package planner
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// unitWords maps case-insensitive unit tokens to a canonical unit name.
+// "m" is deliberately absent here; it is ambiguous between month and minute
+// and is resolved positionally in resolveUnit.
+var unitWords = map[string]string{
+	"y": "year", "yr": "year", "yrs": "year", "year": "year", "years": "year",
+	"mo": "month", "mon": "month", "mons": "month", "month": "month", "months": "month",
+	"w": "week", "wk": "week", "wks": "week", "week": "week", "weeks": "week",
+	"d": "day", "day": "day", "days": "day",
+	"h": "hour", "hr": "hour", "hrs": "hour", "hour": "hour", "hours": "hour",
+	"min": "minute", "mins": "minute", "minute": "minute", "minutes": "minute",
+	"s": "second", "sec": "second", "secs": "second", "second": "second", "seconds": "second",
+}
+
+var weekdayWords = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+var monthNames = map[string]time.Month{
+	"january": time.January, "jan": time.January,
+	"february": time.February, "feb": time.February,
+	"march": time.March, "mar": time.March,
+	"april": time.April, "apr": time.April,
+	"may": time.May,
+	"june": time.June, "jun": time.June,
+	"july": time.July, "jul": time.July,
+	"august": time.August, "aug": time.August,
+	"september": time.September, "sep": time.September, "sept": time.September,
+	"october": time.October, "oct": time.October,
+	"november": time.November, "nov": time.November,
+	"december": time.December, "dec": time.December,
+}
+
+var endpointLayouts = []string{
+	"2006-01-02",
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+}
+
+// scanState names the states walked by the byte scanner in scanDuration.
+type scanState int
+
+const (
+	stateStart scanState = iota
+	stateNumberSeen
+	stateUnitSeen
+	stateKeywordSeen
+)
+
+// ParseAny returns a Period or an Interval parsed from an informal, human-typed
+// phrase, falling back to the strict ISO-8601 grammar handled by FromString.
+// Exactly one of the returned pointers is non-nil on success.
+//
+// Recognized forms include bare ISO-8601 periods ("P1DT2H"), duration phrases
+// ("3 weeks 2 days", "1h30m", "3 days ago", "in 2 hours"), relative keywords
+// ("today", "tomorrow", "yesterday", "next friday", "last month") and
+// informal ranges ("from 2024-01-01 to 2024-03-15", "between March and June").
+// ref and loc anchor keywords and ranges that have no absolute date of their
+// own. errPeriodBadFormat is returned when no recognizer matches.
+func ParseAny(str string, ref time.Time, loc *time.Location) (*Period, *Interval, error) {
+	s := strings.TrimSpace(str)
+	if s == "" {
+		return nil, nil, errPeriodBadFormat
+	}
+
+	if period, err := FromString(s); err == nil {
+		return period, nil, nil
+	}
+
+	lower := strings.ToLower(s)
+
+	if interval, ok := parseRange(lower, ref, loc); ok {
+		return nil, &interval, nil
+	}
+
+	if interval, ok := parseKeywordInterval(lower, ref, loc); ok {
+		return nil, &interval, nil
+	}
+
+	if period, ok := scanDuration(lower); ok {
+		return &period, nil, nil
+	}
+
+	return nil, nil, errPeriodBadFormat
+}
+
+// scanDuration walks str byte-by-byte through stateStart, stateNumberSeen,
+// stateUnitSeen and stateKeywordSeen, accumulating a Period from "<number>
+// <unit>" pairs and honouring the "ago" and "in" keywords.
+func scanDuration(str string) (Period, bool) {
+	var period Period
+	negate := false
+	sawAny := false
+	sawHour := false
+
+	state := stateStart
+	i, n := 0, len(str)
+
+	for i < n {
+		c := str[i]
+
+		switch {
+		case c == ' ' || c == ',':
+			i++
+
+		case c >= '0' && c <= '9':
+			j := i
+			for j < n && str[j] >= '0' && str[j] <= '9' {
+				j++
+			}
+			num, err := strconv.Atoi(str[i:j])
+			if err != nil {
+				return Period{}, false
+			}
+
+			i = j
+			state = stateNumberSeen
+			for i < n && str[i] == ' ' {
+				i++
+			}
+
+			k := i
+			for k < n && isLetter(str[k]) {
+				k++
+			}
+			if k == i {
+				return Period{}, false
+			}
+
+			unit, ok := resolveUnit(str[i:k], sawHour)
+			if !ok {
+				return Period{}, false
+			}
+			if unit == "hour" {
+				sawHour = true
+			}
+
+			applyUnit(&period, unit, num)
+			sawAny = true
+			state = stateUnitSeen
+			i = k
+
+		case isLetter(c):
+			j := i
+			for j < n && isLetter(str[j]) {
+				j++
+			}
+			word := str[i:j]
+			i = j
+
+			switch word {
+			case "ago":
+				negate = true
+				state = stateKeywordSeen
+			case "in", "and":
+				state = stateKeywordSeen
+			default:
+				return Period{}, false
+			}
+
+		default:
+			return Period{}, false
+		}
+	}
+
+	if !sawAny || state == stateStart {
+		return Period{}, false
+	}
+
+	if negate {
+		period = negatePeriod(period)
+	}
+
+	return period, true
+}
+
+// resolveUnit maps a scanned unit token to its canonical name. "m" is
+// ambiguous between month and minute; by convention it means minute once an
+// hour unit has already been seen in the same phrase (as in "1h30m"), and
+// month otherwise (as in "3m" meaning three months).
+func resolveUnit(tok string, sawHour bool) (string, bool) {
+	if tok == "m" {
+		if sawHour {
+			return "minute", true
+		}
+		return "month", true
+	}
+
+	unit, ok := unitWords[tok]
+	return unit, ok
+}
+
+func applyUnit(period *Period, unit string, num int) {
+	switch unit {
+	case "year":
+		period.Years += num
+	case "month":
+		period.Months += num
+	case "week":
+		period.Weeks += num
+	case "day":
+		period.Days += num
+	case "hour":
+		period.Hours += num
+	case "minute":
+		period.Minutes += num
+	case "second":
+		period.Seconds += num
+	}
+}
+
+func negatePeriod(period Period) Period {
+	return Period{
+		Years:   -period.Years,
+		Months:  -period.Months,
+		Weeks:   -period.Weeks,
+		Days:    -period.Days,
+		Hours:   -period.Hours,
+		Minutes: -period.Minutes,
+		Seconds: -period.Seconds,
+	}
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z'
+}
+
+// parseKeywordInterval resolves single keywords and weekday references
+// ("today", "next friday", "last month", ...) against ref into an Interval
+// spanning the referenced day, month or year.
+func parseKeywordInterval(s string, ref time.Time, loc *time.Location) (Interval, bool) {
+	switch s {
+	case "today":
+		return dayInterval(ref, 0, loc), true
+	case "tomorrow":
+		return dayInterval(ref, 1, loc), true
+	case "yesterday":
+		return dayInterval(ref, -1, loc), true
+	case "this month":
+		return monthInterval(ref, 0, loc), true
+	case "next month":
+		return monthInterval(ref, 1, loc), true
+	case "last month":
+		return monthInterval(ref, -1, loc), true
+	case "this year":
+		return yearInterval(ref, 0, loc), true
+	case "next year":
+		return yearInterval(ref, 1, loc), true
+	case "last year":
+		return yearInterval(ref, -1, loc), true
+	}
+
+	if rest, ok := strings.CutPrefix(s, "next "); ok {
+		if weekday, ok := weekdayWords[rest]; ok {
+			return weekdayInterval(ref, weekday, 1, loc), true
+		}
+	}
+
+	if rest, ok := strings.CutPrefix(s, "last "); ok {
+		if weekday, ok := weekdayWords[rest]; ok {
+			return weekdayInterval(ref, weekday, -1, loc), true
+		}
+	}
+
+	return Interval{}, false
+}
+
+func dayInterval(ref time.Time, offsetDays int, loc *time.Location) Interval {
+	ref = ref.In(loc)
+	year, month, day := ref.Date()
+	start := time.Date(year, month, day+offsetDays, 0, 0, 0, 0, loc)
+	return Interval{Start: start, End: start.AddDate(0, 0, 1)}
+}
+
+func monthInterval(ref time.Time, offsetMonths int, loc *time.Location) Interval {
+	ref = ref.In(loc)
+	year, month, _ := ref.Date()
+	start := time.Date(year, month+time.Month(offsetMonths), 1, 0, 0, 0, 0, loc)
+	return Interval{Start: start, End: start.AddDate(0, 1, 0)}
+}
+
+func yearInterval(ref time.Time, offsetYears int, loc *time.Location) Interval {
+	ref = ref.In(loc)
+	start := time.Date(ref.Year()+offsetYears, time.January, 1, 0, 0, 0, 0, loc)
+	return Interval{Start: start, End: start.AddDate(1, 0, 0)}
+}
+
+// weekdayInterval walks day by day from ref, in direction (1 for "next",
+// -1 for "last"), until it lands on weekday, then returns that whole day.
+func weekdayInterval(ref time.Time, weekday time.Weekday, direction int, loc *time.Location) Interval {
+	t := ref.In(loc)
+	for {
+		t = t.AddDate(0, 0, direction)
+		if t.Weekday() == weekday {
+			break
+		}
+	}
+
+	year, month, day := t.Date()
+	start := time.Date(year, month, day, 0, 0, 0, 0, loc)
+	return Interval{Start: start, End: start.AddDate(0, 0, 1)}
+}
+
+// parseRange recognizes the informal range connectors "from A to B" and
+// "between A and B", resolving each side with parseEndpoint.
+func parseRange(s string, ref time.Time, loc *time.Location) (Interval, bool) {
+	if rest, ok := strings.CutPrefix(s, "from "); ok {
+		if a, b, ok := splitOnce(rest, " to "); ok {
+			return makeRange(a, b, ref, loc)
+		}
+	}
+
+	if rest, ok := strings.CutPrefix(s, "between "); ok {
+		if a, b, ok := splitOnce(rest, " and "); ok {
+			return makeRange(a, b, ref, loc)
+		}
+	}
+
+	return Interval{}, false
+}
+
+func splitOnce(s, sep string) (string, string, bool) {
+	idx := strings.Index(s, sep)
+	if idx < 0 {
+		return "", "", false
+	}
+
+	return strings.TrimSpace(s[:idx]), strings.TrimSpace(s[idx+len(sep):]), true
+}
+
+func makeRange(a, b string, ref time.Time, loc *time.Location) (Interval, bool) {
+	start, ok := parseEndpoint(a, ref, loc, true)
+	if !ok {
+		return Interval{}, false
+	}
+
+	end, ok := parseEndpoint(b, ref, loc, false)
+	if !ok {
+		return Interval{}, false
+	}
+
+	return Interval{Start: start, End: end}, true
+}
+
+// parseEndpoint resolves one side of an informal range: either a bare month
+// name (taken in ref's year, and expanded to the month's end when it is the
+// closing side) or an absolute date in one of endpointLayouts.
+func parseEndpoint(s string, ref time.Time, loc *time.Location, isStart bool) (time.Time, bool) {
+	if month, ok := monthNames[s]; ok {
+		start := time.Date(ref.In(loc).Year(), month, 1, 0, 0, 0, 0, loc)
+		if isStart {
+			return start, true
+		}
+		return start.AddDate(0, 1, 0), true
+	}
+
+	for _, layout := range endpointLayouts {
+		if t, err := time.ParseInLocation(layout, strings.ToUpper(s), loc); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
@@ -0,0 +1,91 @@
+package planner
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseAnyDuration(t *testing.T) {
+	ref := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	period, interval, err := ParseAny("3 weeks 2 days", ref, time.UTC)
+	if err != nil || interval != nil || *period != (Period{Weeks: 3, Days: 2}) {
+		t.Fatalf("Unexpected result: %v %v %v", period, interval, err)
+	}
+
+	period, interval, err = ParseAny("1h30m", ref, time.UTC)
+	if err != nil || interval != nil || *period != (Period{Hours: 1, Minutes: 30}) {
+		t.Fatalf("Unexpected result: %v %v %v", period, interval, err)
+	}
+
+	period, interval, err = ParseAny("3 days ago", ref, time.UTC)
+	if err != nil || interval != nil || *period != (Period{Days: -3}) {
+		t.Fatalf("Unexpected result: %v %v %v", period, interval, err)
+	}
+
+	period, interval, err = ParseAny("in 2 hours", ref, time.UTC)
+	if err != nil || interval != nil || *period != (Period{Hours: 2}) {
+		t.Fatalf("Unexpected result: %v %v %v", period, interval, err)
+	}
+}
+
+func TestParseAnyKeywords(t *testing.T) {
+	ref := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	period, interval, err := ParseAny("today", ref, time.UTC)
+	expected := Interval{Start: time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 6, 16, 0, 0, 0, 0, time.UTC)}
+	if err != nil || period != nil || *interval != expected {
+		t.Fatalf("Unexpected result: %v %v %v", period, interval, err)
+	}
+
+	period, interval, err = ParseAny("tomorrow", ref, time.UTC)
+	expected = Interval{Start: time.Date(2024, 6, 16, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 6, 17, 0, 0, 0, 0, time.UTC)}
+	if err != nil || period != nil || *interval != expected {
+		t.Fatalf("Unexpected result: %v %v %v", period, interval, err)
+	}
+
+	period, interval, err = ParseAny("last month", ref, time.UTC)
+	expected = Interval{Start: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)}
+	if err != nil || period != nil || *interval != expected {
+		t.Fatalf("Unexpected result: %v %v %v", period, interval, err)
+	}
+
+	period, interval, err = ParseAny("next friday", ref, time.UTC)
+	if err != nil || period != nil || interval.Start.Weekday() != time.Friday {
+		t.Fatalf("Unexpected result: %v %v %v", period, interval, err)
+	}
+}
+
+func TestParseAnyRange(t *testing.T) {
+	ref := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	period, interval, err := ParseAny("from 2024-01-01 to 2024-03-15", ref, time.UTC)
+	expected := Interval{Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)}
+	if err != nil || period != nil || *interval != expected {
+		t.Fatalf("Unexpected result: %v %v %v", period, interval, err)
+	}
+
+	period, interval, err = ParseAny("between March and June", ref, time.UTC)
+	expected = Interval{Start: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)}
+	if err != nil || period != nil || *interval != expected {
+		t.Fatalf("Unexpected result: %v %v %v", period, interval, err)
+	}
+}
+
+func TestParseAnyFallsBackToFromString(t *testing.T) {
+	ref := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	period, interval, err := ParseAny("P1Y2M3DT4H", ref, time.UTC)
+	if err != nil || interval != nil || *period != (Period{Years: 1, Months: 2, Days: 3, Hours: 4}) {
+		t.Fatalf("Unexpected result: %v %v %v", period, interval, err)
+	}
+}
+
+func TestParseAnyBadFormat(t *testing.T) {
+	ref := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	_, _, err := ParseAny("gibberish not a period", ref, time.UTC)
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+}
@@ -221,14 +221,41 @@ func (period Period) Normalize() Period {
 	return Period{years, months, 0, days, hours, minutes, seconds}
 }
 
+// ApplyOption configures Period.Apply.
+type ApplyOption func(*applyOptions)
+
+type applyOptions struct {
+	clampEndOfMonth bool
+}
+
+// ClampEndOfMonth makes Apply clamp a period without Days or Weeks to the last day of the
+// resulting month instead of letting it overflow into the following month, e.g. with
+// ClampEndOfMonth, P1M applied to Jan 31 yields Feb 28 (or 29) rather than Mar 2/3.
+func ClampEndOfMonth() ApplyOption {
+	return func(o *applyOptions) { o.clampEndOfMonth = true }
+}
+
 // Apply period to timestamp and return result.
-func (period Period) Apply(t time.Time) time.Time {
+func (period Period) Apply(t time.Time, opts ...ApplyOption) time.Time {
+	var options applyOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	year, month, day := t.Date()
 	hour, minute, second, nanos := t.Hour(), t.Minute(), t.Second(), t.Nanosecond()
 
+	targetYear, targetMonth := year+period.Years, month+time.Month(period.Months)
+
+	if options.clampEndOfMonth && period.Days == 0 && period.Weeks == 0 {
+		if lastDay := DaysInMonth(targetYear, targetMonth, t.Location()); day > lastDay {
+			day = lastDay
+		}
+	}
+
 	duration := time.Hour*time.Duration(period.Hours) + time.Minute*time.Duration(period.Minutes) + time.Second*time.Duration(period.Seconds)
 
 	// handles DST transitions appropriately by adding duration, instead of adding hours/minutes/seconds directly upon date construction
-	result := time.Date(year+period.Years, month+time.Month(period.Months), day+period.Days, hour, minute, second, nanos, t.Location()).Add(duration)
+	result := time.Date(targetYear, targetMonth, day+period.Days+period.Weeks*7, hour, minute, second, nanos, t.Location()).Add(duration)
 	return result
 }
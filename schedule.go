@@ -0,0 +1,309 @@
+package planner
+
+import (
+	"time"
+)
+
+// maxScheduleLookahead bounds the number of ticks Next, Prev and tickAtOrAfter
+// will step through looking for a match, so that a non-advancing step (e.g.
+// the zero Period) or a predicate combination that can never match (e.g.
+// OnMonths(time.February).OnMonthDays(30)) gives up instead of looping forever.
+const maxScheduleLookahead = 100000
+
+// Schedule generates timestamps by repeatedly applying a Period to an anchor,
+// optionally narrowed by cron-style predicates (OnWeekdays, OnMonths,
+// OnMonthDays, ExcludeDates) and a termination condition (Count or Until).
+// Because Period.Apply handles DST correctly, ticks remain calendar-correct
+// across transitions. By default a month/year step overflows short months
+// (P1M from Jan 31 lands on Mar 2/3); opt into ClampEndOfMonth to clamp it to
+// the last day of the month instead.
+type Schedule struct {
+	anchor time.Time
+	step   Period
+	loc    *time.Location
+
+	weekdays  []time.Weekday
+	months    []time.Month
+	monthDays []int
+	excluded  []time.Time
+
+	count           int
+	until           time.Time
+	clampEndOfMonth bool
+}
+
+// NewSchedule returns a schedule ticking by step from anchor, in loc. A step
+// that does not advance t (the zero Period, or one whose components cancel
+// out) makes Next and Prev give up after maxScheduleLookahead ticks and return
+// the zero Time, while Occurrences and Iterate stop after the first tick.
+func NewSchedule(anchor time.Time, step Period, loc *time.Location) *Schedule {
+	return &Schedule{anchor: anchor.In(loc), step: step, loc: loc}
+}
+
+// ClampEndOfMonth makes month/year steps clamp to the last day of the
+// resulting month (see Period.Apply's ClampEndOfMonth option) instead of
+// overflowing into the following month.
+func (s *Schedule) ClampEndOfMonth() *Schedule {
+	s.clampEndOfMonth = true
+	return s
+}
+
+// apply advances t by the schedule's step, honouring ClampEndOfMonth.
+func (s *Schedule) apply(t time.Time) time.Time {
+	if s.clampEndOfMonth {
+		return s.step.Apply(t, ClampEndOfMonth())
+	}
+	return s.step.Apply(t)
+}
+
+// applyBack steps t backward by the schedule's step, honouring ClampEndOfMonth.
+func (s *Schedule) applyBack(t time.Time) time.Time {
+	if s.clampEndOfMonth {
+		return negatePeriod(s.step).Apply(t, ClampEndOfMonth())
+	}
+	return negatePeriod(s.step).Apply(t)
+}
+
+// OnWeekdays restricts occurrences to the given weekdays.
+func (s *Schedule) OnWeekdays(weekdays ...time.Weekday) *Schedule {
+	s.weekdays = append(s.weekdays, weekdays...)
+	return s
+}
+
+// OnMonths restricts occurrences to the given months.
+func (s *Schedule) OnMonths(months ...time.Month) *Schedule {
+	s.months = append(s.months, months...)
+	return s
+}
+
+// OnMonthDays restricts occurrences to the given days of the month.
+func (s *Schedule) OnMonthDays(days ...int) *Schedule {
+	s.monthDays = append(s.monthDays, days...)
+	return s
+}
+
+// ExcludeDates removes occurrences that fall on the given dates, compared by
+// calendar date in the schedule's location.
+func (s *Schedule) ExcludeDates(dates ...time.Time) *Schedule {
+	s.excluded = append(s.excluded, dates...)
+	return s
+}
+
+// Count terminates Occurrences and Iterate after n matching occurrences.
+func (s *Schedule) Count(n int) *Schedule {
+	s.count = n
+	return s
+}
+
+// Until terminates Occurrences and Iterate at the first occurrence after t.
+func (s *Schedule) Until(t time.Time) *Schedule {
+	s.until = t.In(s.loc)
+	return s
+}
+
+// Next returns the first matching occurrence strictly after t, or the zero
+// Time if the step does not advance or no tick matches within
+// maxScheduleLookahead attempts.
+func (s *Schedule) Next(after time.Time) time.Time {
+	after = after.In(s.loc)
+	t := s.anchor
+
+	for i := 0; t.After(after); i++ {
+		if i >= maxScheduleLookahead {
+			return time.Time{}
+		}
+		t = s.applyBack(t)
+	}
+	for i := 0; !t.After(after); i++ {
+		if i >= maxScheduleLookahead {
+			return time.Time{}
+		}
+		t = s.apply(t)
+	}
+	for i := 0; !s.matches(t); i++ {
+		if i >= maxScheduleLookahead {
+			return time.Time{}
+		}
+		t = s.apply(t)
+	}
+
+	return t
+}
+
+// Prev returns the last matching occurrence strictly before t, or the zero
+// Time if the step does not advance or no tick matches within
+// maxScheduleLookahead attempts.
+func (s *Schedule) Prev(before time.Time) time.Time {
+	before = before.In(s.loc)
+	t := s.anchor
+
+	for i := 0; t.Before(before); i++ {
+		if i >= maxScheduleLookahead {
+			return time.Time{}
+		}
+		t = s.apply(t)
+	}
+	for i := 0; !t.Before(before); i++ {
+		if i >= maxScheduleLookahead {
+			return time.Time{}
+		}
+		t = s.applyBack(t)
+	}
+	for i := 0; !s.matches(t); i++ {
+		if i >= maxScheduleLookahead {
+			return time.Time{}
+		}
+		t = s.applyBack(t)
+	}
+
+	return t
+}
+
+// Occurrences returns every matching tick within the interval, in order,
+// honouring Count and Until if set. It stops early, without erroring, if the
+// step does not advance t.
+func (s *Schedule) Occurrences(within Interval) []time.Time {
+	var result []time.Time
+
+	for t := s.tickAtOrAfter(within.Start); within.Contains(t); {
+		if !s.untilAllows(t) {
+			break
+		}
+
+		next := s.apply(t)
+		if s.matches(t) {
+			if s.count > 0 && len(result) >= s.count {
+				break
+			}
+
+			result = append(result, t)
+		}
+		if !next.After(t) {
+			break
+		}
+		t = next
+	}
+
+	return result
+}
+
+// Iterate calls yield with each matching tick from the first one at or after
+// from, in order, honouring Count and Until if set. Without either bound the
+// sequence is unbounded; yield's return value controls when to stop. It also
+// stops, without erroring, if the step does not advance t.
+func (s *Schedule) Iterate(from time.Time, yield func(time.Time) bool) {
+	count := 0
+
+	for t := s.tickAtOrAfter(from); ; {
+		if !s.untilAllows(t) {
+			return
+		}
+
+		next := s.apply(t)
+		if s.matches(t) {
+			if s.count > 0 && count >= s.count {
+				return
+			}
+
+			count++
+			if !yield(t) {
+				return
+			}
+		}
+		if !next.After(t) {
+			return
+		}
+		t = next
+	}
+}
+
+// tickAtOrAfter returns the earliest tick of the schedule's step, unfiltered
+// by predicates, at or after ref. It gives up and returns ref's nearest tick
+// found so far if the step does not advance within maxScheduleLookahead
+// attempts.
+func (s *Schedule) tickAtOrAfter(ref time.Time) time.Time {
+	ref = ref.In(s.loc)
+	t := s.anchor
+
+	for i := 0; t.Before(ref); i++ {
+		if i >= maxScheduleLookahead {
+			return t
+		}
+		next := s.apply(t)
+		if !next.After(t) {
+			return t
+		}
+		t = next
+	}
+	for i := 0; ; i++ {
+		if i >= maxScheduleLookahead {
+			return t
+		}
+		prev := s.applyBack(t)
+		if !prev.Before(t) || prev.Before(ref) {
+			break
+		}
+		t = prev
+	}
+
+	return t
+}
+
+func (s *Schedule) untilAllows(t time.Time) bool {
+	return s.until.IsZero() || !t.After(s.until)
+}
+
+// matches reports whether t satisfies every configured predicate.
+func (s *Schedule) matches(t time.Time) bool {
+	t = t.In(s.loc)
+
+	if len(s.weekdays) > 0 && !weekdayIn(t.Weekday(), s.weekdays) {
+		return false
+	}
+	if len(s.months) > 0 && !monthIn(t.Month(), s.months) {
+		return false
+	}
+	if len(s.monthDays) > 0 && !intIn(t.Day(), s.monthDays) {
+		return false
+	}
+	for _, excluded := range s.excluded {
+		if sameDate(t, excluded.In(s.loc)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func weekdayIn(weekday time.Weekday, weekdays []time.Weekday) bool {
+	for _, w := range weekdays {
+		if w == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+func monthIn(month time.Month, months []time.Month) bool {
+	for _, m := range months {
+		if m == month {
+			return true
+		}
+	}
+	return false
+}
+
+func intIn(n int, values []int) bool {
+	for _, v := range values {
+		if v == n {
+			return true
+		}
+	}
+	return false
+}
+
+func sameDate(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
@@ -0,0 +1,153 @@
+package planner
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScheduleNextPrev(t *testing.T) {
+	anchor := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	s := NewSchedule(anchor, Period{Weeks: 1}, time.UTC)
+
+	next := s.Next(anchor)
+	if next != anchor.AddDate(0, 0, 7) {
+		t.Fatalf("Unexpected next: %v", next)
+	}
+
+	next = s.Next(time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC))
+	if next != time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC) {
+		t.Fatalf("Unexpected next: %v", next)
+	}
+
+	prev := s.Prev(time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC))
+	if prev != time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC) {
+		t.Fatalf("Unexpected prev: %v", prev)
+	}
+}
+
+func TestScheduleOnWeekdays(t *testing.T) {
+	anchor := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC) // Monday
+	s := NewSchedule(anchor, Period{Days: 1}, time.UTC).OnWeekdays(time.Monday, time.Wednesday, time.Friday)
+
+	within := Interval{Start: anchor, End: anchor.AddDate(0, 0, 14)}
+	result := s.Occurrences(within)
+
+	if len(result) != 6 {
+		t.Fatalf("Unexpected occurrence count: %d", len(result))
+	}
+	for _, occurrence := range result {
+		weekday := occurrence.Weekday()
+		if weekday != time.Monday && weekday != time.Wednesday && weekday != time.Friday {
+			t.Fatalf("Unexpected weekday: %v", weekday)
+		}
+	}
+}
+
+func TestScheduleExcludeDates(t *testing.T) {
+	anchor := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	s := NewSchedule(anchor, Period{Days: 1}, time.UTC).ExcludeDates(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC))
+
+	within := Interval{Start: anchor, End: anchor.AddDate(0, 0, 5)}
+	result := s.Occurrences(within)
+
+	if len(result) != 4 {
+		t.Fatalf("Unexpected occurrence count: %d", len(result))
+	}
+	for _, occurrence := range result {
+		if occurrence.Day() == 3 {
+			t.Fatal("Expected excluded date to be skipped")
+		}
+	}
+}
+
+func TestScheduleCount(t *testing.T) {
+	anchor := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	s := NewSchedule(anchor, Period{Days: 1}, time.UTC).Count(3)
+
+	within := Interval{Start: anchor, End: anchor.AddDate(1, 0, 0)}
+	result := s.Occurrences(within)
+
+	if len(result) != 3 {
+		t.Fatalf("Unexpected occurrence count: %d", len(result))
+	}
+}
+
+func TestScheduleIterate(t *testing.T) {
+	anchor := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	s := NewSchedule(anchor, Period{Days: 1}, time.UTC).Count(3)
+
+	var ticks []time.Time
+	s.Iterate(anchor, func(t time.Time) bool {
+		ticks = append(ticks, t)
+		return true
+	})
+
+	if len(ticks) != 3 {
+		t.Fatalf("Unexpected tick count: %d", len(ticks))
+	}
+}
+
+func TestScheduleNonAdvancingStep(t *testing.T) {
+	anchor := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	s := NewSchedule(anchor, Period{}, time.UTC)
+
+	if next := s.Next(anchor); !next.IsZero() {
+		t.Fatalf("Expected zero Time for a non-advancing step, got: %v", next)
+	}
+	if prev := s.Prev(anchor); !prev.IsZero() {
+		t.Fatalf("Expected zero Time for a non-advancing step, got: %v", prev)
+	}
+
+	within := Interval{Start: anchor, End: anchor.AddDate(0, 0, 1)}
+	if result := s.Occurrences(within); len(result) != 1 {
+		t.Fatalf("Expected a single occurrence for a non-advancing step, got: %v", result)
+	}
+}
+
+func TestScheduleImpossiblePredicate(t *testing.T) {
+	anchor := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	s := NewSchedule(anchor, Period{Days: 1}, time.UTC).OnMonths(time.February).OnMonthDays(30)
+
+	if next := s.Next(anchor); !next.IsZero() {
+		t.Fatalf("Expected zero Time for an impossible predicate, got: %v", next)
+	}
+	if prev := s.Prev(anchor); !prev.IsZero() {
+		t.Fatalf("Expected zero Time for an impossible predicate, got: %v", prev)
+	}
+}
+
+func TestScheduleClampEndOfMonth(t *testing.T) {
+	anchor := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	s := NewSchedule(anchor, Period{Months: 1}, time.UTC).ClampEndOfMonth().Count(3)
+
+	within := Interval{Start: anchor, End: anchor.AddDate(1, 0, 0)}
+	result := s.Occurrences(within)
+
+	expected := []time.Time{
+		time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 29, 0, 0, 0, 0, time.UTC),
+	}
+	if len(result) != len(expected) {
+		t.Fatalf("Unexpected occurrences: %v", result)
+	}
+	for i, e := range expected {
+		if result[i] != e {
+			t.Fatalf("Unexpected occurrence %d: got %v, want %v", i, result[i], e)
+		}
+	}
+}
+
+func TestApplyClampEndOfMonth(t *testing.T) {
+	ref := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	result := Period{Months: 1}.Apply(ref, ClampEndOfMonth())
+	if result != time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("Unexpected clamped result: %v", result)
+	}
+
+	result = Period{Months: 1}.Apply(ref)
+	if result != time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC) {
+		t.Fatalf("Unexpected unclamped result: %v", result)
+	}
+}